@@ -0,0 +1,278 @@
+package congress
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Bill holds the data shared by a bill no matter which method was used
+// to request it.
+type Bill struct {
+	ID           string `json:"bill_id"`
+	Number       string `json:"number"`
+	BillType     string `json:"bill_type,omitempty"`
+	Title        string `json:"title"`
+	ShortTitle   string `json:"short_title,omitempty"`
+	SponsorID    string `json:"sponsor_id"`
+	SponsorName  string `json:"sponsor_name,omitempty"`
+	SponsorState string `json:"sponsor_state,omitempty"`
+	SponsorParty string `json:"sponsor_party,omitempty"`
+	Congress     string `json:"congress"`
+
+	// URL links to the endpoint for information about only this bill.
+	URL string `json:"api_uri,omitempty"`
+
+	// CongressdotgovURL links to the bill's page on congress.gov.
+	CongressdotgovURL string `json:"congressdotgov_url,omitempty"`
+	GovtrackURL       string `json:"govtrack_url,omitempty"`
+
+	IntroducedDate string `json:"introduced_date,omitempty"`
+	Summary        string `json:"summary,omitempty"`
+	SummaryShort   string `json:"summary_short,omitempty"`
+
+	Cosponsors        int      `json:"cosponsors,omitempty"`
+	CosponsorsD       int      `json:"cosponsors_d,omitempty"`
+	CosponsorsR       int      `json:"cosponsors_r,omitempty"`
+	CommitteeCodes    []string `json:"committee_codes,omitempty"`
+	SubcommitteeCodes []string `json:"subcommittee_codes,omitempty"`
+
+	PrimarySubject string `json:"primary_subject,omitempty"`
+
+	LatestAction
+}
+
+// LatestAction describes the most recent recorded action taken on a bill.
+type LatestAction struct {
+	Date   string `json:"latest_major_action_date,omitempty"`
+	Action string `json:"latest_major_action,omitempty"`
+}
+
+// BillSummary holds the data of a bill when sent as part of a collection,
+// such as a search result or a list of recent bills.
+type BillSummary struct {
+	Bill
+	House   bool `json:"house_passage,omitempty"`
+	Senate  bool `json:"senate_passage,omitempty"`
+	Enacted bool `json:"enacted,omitempty"`
+	Vetoed  bool `json:"vetoed,omitempty"`
+}
+
+// BillDetails holds the data of a bill when it is requested specifically
+// about that bill, including its cosponsors, subjects, and actions.
+type BillDetails struct {
+	Bill
+	Committees     string        `json:"committees,omitempty"`
+	CommitteeCodes []string      `json:"committee_codes,omitempty"`
+	Cosponsors     []Cosponsor   `json:"cosponsors_data,omitempty"`
+	Subjects       []BillSubject `json:"subjects,omitempty"`
+	RelatedBills   []RelatedBill `json:"related_bills,omitempty"`
+	Actions        []BillAction  `json:"actions,omitempty"`
+}
+
+// Cosponsor is a single member of Congress who has cosponsored a bill.
+type Cosponsor struct {
+	ID       string `json:"cosponsor_id"`
+	Name     string `json:"name"`
+	State    string `json:"state"`
+	Party    string `json:"party"`
+	Date     string `json:"date"`
+	URL      string `json:"cosponsor_uri,omitempty"`
+	Original bool   `json:"original_cosponsor"`
+}
+
+// BillSubject is a single legislative subject a bill has been tagged with.
+type BillSubject struct {
+	Name string `json:"name"`
+}
+
+// RelatedBill is a bill identified by ProPublica as related to another bill.
+type RelatedBill struct {
+	ID       string `json:"bill_id"`
+	Title    string `json:"title"`
+	Congress string `json:"congress"`
+	Reason   string `json:"reason"`
+	URL      string `json:"api_uri,omitempty"`
+}
+
+// BillAction is a single recorded action taken on a bill.
+type BillAction struct {
+	ID          int    `json:"id"`
+	Chamber     string `json:"chamber,omitempty"`
+	ActionType  string `json:"action_type,omitempty"`
+	Datetime    string `json:"datetime"`
+	Description string `json:"description"`
+}
+
+// getBillResponse is the format of the response received from the
+// Congress API "get bill" and related single-bill endpoints.
+type getBillResponse struct {
+	Status    string        `json:"status"`
+	Copyright string        `json:"copyright"`
+	Results   []BillDetails `json:"results"`
+}
+
+// GetBill fetches detailed information about a single bill, identified by
+// its bill ID (i.e. "hr1234") within a particular congress.
+func (c *Client) GetBill(ctx context.Context, congress int, billID string) (bill BillDetails, err error) {
+	var unmarshaled getBillResponse
+	err = c.get(ctx, fmt.Sprintf("%s/%d/bills/%s.json", c.Endpoint, congress, billID), &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Results) > 0 {
+		bill = unmarshaled.Results[0]
+	}
+	return
+}
+
+// getBillCosponsorsResponse is the format of the response received from the
+// Congress API "get bill cosponsors" endpoint.
+type getBillCosponsorsResponse struct {
+	Status    string                `json:"status"`
+	Copyright string                `json:"copyright"`
+	Results   []getCosponsorResults `json:"results"`
+}
+
+type getCosponsorResults struct {
+	Bill
+	Cosponsors []Cosponsor `json:"cosponsors"`
+}
+
+// GetBillCosponsors fetches the full list of members of Congress who have
+// cosponsored a single bill.
+func (c *Client) GetBillCosponsors(ctx context.Context, congress int, billID string) (cosponsors []Cosponsor, err error) {
+	var unmarshaled getBillCosponsorsResponse
+	err = c.get(ctx, fmt.Sprintf("%s/%d/bills/%s/cosponsors.json", c.Endpoint, congress, billID), &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Results) > 0 {
+		cosponsors = unmarshaled.Results[0].Cosponsors
+	}
+	return
+}
+
+// getBillSubjectsResponse is the format of the response received from the
+// Congress API "get bill subjects" endpoint.
+type getBillSubjectsResponse struct {
+	Status    string                  `json:"status"`
+	Copyright string                  `json:"copyright"`
+	Results   []getBillSubjectResults `json:"results"`
+}
+
+type getBillSubjectResults struct {
+	Bill
+	Subjects []BillSubject `json:"subjects"`
+}
+
+// GetBillSubjects fetches the legislative subjects a single bill has been
+// tagged with.
+func (c *Client) GetBillSubjects(ctx context.Context, congress int, billID string) (subjects []BillSubject, err error) {
+	var unmarshaled getBillSubjectsResponse
+	err = c.get(ctx, fmt.Sprintf("%s/%d/bills/%s/subjects.json", c.Endpoint, congress, billID), &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Results) > 0 {
+		subjects = unmarshaled.Results[0].Subjects
+	}
+	return
+}
+
+// getRelatedBillsResponse is the format of the response received from the
+// Congress API "get related bills" endpoint.
+type getRelatedBillsResponse struct {
+	Status    string                   `json:"status"`
+	Copyright string                   `json:"copyright"`
+	Results   []getRelatedBillsResults `json:"results"`
+}
+
+type getRelatedBillsResults struct {
+	Bill
+	RelatedBills []RelatedBill `json:"related_bills"`
+}
+
+// GetRelatedBills fetches the bills ProPublica has identified as related to
+// a single bill.
+func (c *Client) GetRelatedBills(ctx context.Context, congress int, billID string) (related []RelatedBill, err error) {
+	var unmarshaled getRelatedBillsResponse
+	err = c.get(ctx, fmt.Sprintf("%s/%d/bills/%s/related.json", c.Endpoint, congress, billID), &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Results) > 0 {
+		related = unmarshaled.Results[0].RelatedBills
+	}
+	return
+}
+
+// getBillsResponse is the format of the response received from the
+// Congress API "recent bills" and "search bills" endpoints.
+type getBillsResponse struct {
+	Status    string            `json:"status"`
+	Copyright string            `json:"copyright"`
+	Results   []getBillsResults `json:"results"`
+}
+
+type getBillsResults struct {
+	Congress   string        `json:"congress,omitempty"`
+	Chamber    string        `json:"chamber,omitempty"`
+	NumResults int           `json:"num_results,omitempty"`
+	Offset     int           `json:"offset,omitempty"`
+	Bills      []BillSummary `json:"bills"`
+}
+
+// GetRecentBills fetches the most recent bills of a given type ("introduced",
+// "updated", "passed", or "major") for a single chamber of a single congress.
+func (c *Client) GetRecentBills(ctx context.Context, congress int, chamber, billType string) (bills []BillSummary, err error) {
+	var unmarshaled getBillsResponse
+	err = c.get(ctx, fmt.Sprintf("%s/%d/%s/bills/%s.json", c.Endpoint, congress, chamber, billType), &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Results) > 0 {
+		bills = unmarshaled.Results[0].Bills
+	}
+	return
+}
+
+// BillSearchOptions filters the results of a bill search. Every field is
+// optional; zero values are left off the request.
+type BillSearchOptions struct {
+	// Congress limits the search to a single congress (i.e. 115). If zero,
+	// ProPublica searches the current congress.
+	Congress int
+
+	// Sort is either "_score" (relevance, the default) or "date".
+	Sort string
+
+	// Dir is the sort direction: "desc" (the default) or "asc".
+	Dir string
+}
+
+// SearchBills searches the full text of introduced legislation for a query
+// string, optionally narrowed by BillSearchOptions.
+func (c *Client) SearchBills(ctx context.Context, query string, opts BillSearchOptions) (bills []BillSummary, err error) {
+	params := url.Values{}
+	params.Set("query", query)
+	if opts.Congress != 0 {
+		params.Set("congress", strconv.Itoa(opts.Congress))
+	}
+	if opts.Sort != "" {
+		params.Set("sort", opts.Sort)
+	}
+	if opts.Dir != "" {
+		params.Set("dir", opts.Dir)
+	}
+	var unmarshaled getBillsResponse
+	err = c.get(ctx, fmt.Sprintf("%s/bills/search.json?%s", c.Endpoint, params.Encode()), &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Results) > 0 {
+		bills = unmarshaled.Results[0].Bills
+	}
+	return
+}
@@ -1,5 +1,7 @@
 package congress
 
+import "time"
+
 // Client holds the connection information for sending data to the API.
 type Client struct {
 	// Endpoint is the URL of the ProPublica Congress API
@@ -7,4 +9,36 @@ type Client struct {
 
 	// Key is the user's ProPublica API key
 	Key string
+
+	// Geocoder resolves addresses to coordinates for GetMembersByAddress.
+	// If nil, CensusGeocoder is used.
+	Geocoder GeocoderProvider
+
+	// Resolver resolves coordinates to a congressional district for
+	// GetMembersByLatLng and GetMembersByAddress. If nil,
+	// CensusDistrictResolver is used.
+	Resolver DistrictResolver
+
+	// HTTP issues the underlying HTTP requests. If nil, http.DefaultClient
+	// is used. Inject a custom HTTPDoer for instrumentation or testing.
+	HTTP HTTPDoer
+
+	// RateLimiter throttles outgoing requests. If nil, DefaultRateLimiter is
+	// used, which throttles to roughly ProPublica's recommended one
+	// request per second.
+	RateLimiter RateLimiter
+
+	// Cache stores responses so repeated reads don't count against
+	// ProPublica's daily request limit. If nil, responses are not cached.
+	Cache ResponseCache
+
+	// CacheTTL is how long a cached response is considered fresh enough to
+	// return without making a request at all. If zero, DefaultCacheTTL is
+	// used. A response's own Cache-Control max-age, if present, overrides
+	// this on a per-entry basis. Has no effect if Cache is nil.
+	CacheTTL time.Duration
+
+	// RetryPolicy controls retries of requests that fail with a 429 or a
+	// 5xx response. The zero value falls back to DefaultRetryPolicy.
+	RetryPolicy RetryPolicy
 }
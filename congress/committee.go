@@ -0,0 +1,94 @@
+package congress
+
+import (
+	"context"
+	"fmt"
+)
+
+// Committee holds the data of a congressional committee when sent as part
+// of a collection, such as the list of committees for a chamber.
+type Committee struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Chamber  string `json:"chamber"`
+	URL      string `json:"api_uri,omitempty"`
+	Congress string `json:"congress,omitempty"`
+}
+
+// CommitteeDetails holds the data of a congressional committee when it is
+// requested specifically about that committee, including its membership
+// and subcommittees.
+type CommitteeDetails struct {
+	Committee
+	Address       string                  `json:"address,omitempty"`
+	Phone         string                  `json:"phone,omitempty"`
+	URI           string                  `json:"url,omitempty"`
+	Subcommittees []CommitteeSubcommittee `json:"subcommittees,omitempty"`
+	Members       []CommitteeMember       `json:"current_members,omitempty"`
+}
+
+// CommitteeSubcommittee is a subcommittee belonging to a committee.
+type CommitteeSubcommittee struct {
+	ID   string `json:"thomas_id"`
+	Name string `json:"name"`
+}
+
+// CommitteeMember is a single member of Congress who sits on a committee.
+type CommitteeMember struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Party    string `json:"party"`
+	Rank     int    `json:"rank"`
+	Title    string `json:"title,omitempty"`
+	SideCode string `json:"side_code,omitempty"`
+}
+
+// getCommitteesResponse is the format of the response received from the
+// Congress API "get committees" endpoint.
+type getCommitteesResponse struct {
+	Status    string                 `json:"status"`
+	Copyright string                 `json:"copyright"`
+	Results   []getCommitteesResults `json:"results"`
+}
+
+type getCommitteesResults struct {
+	Congress   string      `json:"congress"`
+	Chamber    string      `json:"chamber"`
+	Committees []Committee `json:"committees"`
+}
+
+// GetCommittees fetches the list of committees for a single chamber of a
+// single congress.
+func (c *Client) GetCommittees(ctx context.Context, congress int, chamber string) (committees []Committee, err error) {
+	var unmarshaled getCommitteesResponse
+	err = c.get(ctx, fmt.Sprintf("%s/%d/%s/committees.json", c.Endpoint, congress, chamber), &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Results) > 0 {
+		committees = unmarshaled.Results[0].Committees
+	}
+	return
+}
+
+// getCommitteeResponse is the format of the response received from the
+// Congress API "get committee" endpoint.
+type getCommitteeResponse struct {
+	Status    string             `json:"status"`
+	Copyright string             `json:"copyright"`
+	Results   []CommitteeDetails `json:"results"`
+}
+
+// GetCommittee fetches detailed information about a single committee,
+// including its current membership and subcommittees.
+func (c *Client) GetCommittee(ctx context.Context, congress int, chamber, id string) (committee CommitteeDetails, err error) {
+	var unmarshaled getCommitteeResponse
+	err = c.get(ctx, fmt.Sprintf("%s/%d/%s/committees/%s.json", c.Endpoint, congress, chamber, id), &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Results) > 0 {
+		committee = unmarshaled.Results[0]
+	}
+	return
+}
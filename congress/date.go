@@ -0,0 +1,50 @@
+package congress
+
+import (
+	"strings"
+	"time"
+)
+
+// Date wraps time.Time so date/time fields can be unmarshaled directly
+// from JSON instead of being left as raw strings for callers to parse.
+// The Congress API sends dates in a handful of formats ("2006-01-02",
+// RFC3339, or an empty string for fields that simply aren't set yet), all
+// of which Date accepts.
+type Date struct {
+	time.Time
+}
+
+// dateLayouts are tried in order when unmarshaling a Date.
+var dateLayouts = []string{
+	"2006-01-02",
+	time.RFC3339,
+}
+
+// UnmarshalJSON parses a JSON string in one of the Congress API's date
+// formats. An empty string unmarshals to the zero Date.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		d.Time = time.Time{}
+		return nil
+	}
+	var err error
+	for _, layout := range dateLayouts {
+		var t time.Time
+		t, err = time.Parse(layout, s)
+		if err == nil {
+			d.Time = t
+			return nil
+		}
+	}
+	return err
+}
+
+// MarshalJSON writes the Date back out as "2006-01-02", or an empty string
+// for the zero Date.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.Time.IsZero() {
+		return []byte(`""`), nil
+	}
+	return []byte(`"` + d.Time.Format("2006-01-02") + `"`), nil
+}
@@ -0,0 +1,107 @@
+package congress
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors for common failure modes, usable with errors.Is against
+// any error returned by a Client method.
+var (
+	// ErrNotFound indicates the API responded with a 404.
+	ErrNotFound = errors.New("congress: not found")
+
+	// ErrUnauthorized indicates the API responded with a 401 or 403,
+	// usually because Client.Key is missing or invalid.
+	ErrUnauthorized = errors.New("congress: unauthorized")
+
+	// ErrRateLimited indicates the API responded with a 429.
+	ErrRateLimited = errors.New("congress: rate limited")
+)
+
+// APIError is returned whenever the Congress API responds with a non-2xx
+// status code. It preserves the status code, ProPublica's own "status" and
+// error fields, and the raw response body for callers that need more than
+// the sentinel errors provide.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Status is ProPublica's own "status" field, i.e. "ERROR".
+	Status string
+
+	// Errors holds the individual error messages ProPublica included in
+	// the response body, if any.
+	Errors []string
+
+	// Body is the raw response body.
+	Body []byte
+
+	// URL is the request URL that produced this error.
+	URL string
+
+	retryAfter time.Duration
+}
+
+// apiErrorBody is the shape of the error payload ProPublica sends back
+// alongside non-2xx responses.
+type apiErrorBody struct {
+	Status string `json:"status"`
+	Errors []struct {
+		Error string `json:"error"`
+	} `json:"errors"`
+}
+
+// newAPIError builds an APIError from a response's status code, URL, raw
+// body, and (for 429s) the parsed Retry-After delay.
+func newAPIError(statusCode int, url string, body []byte, retryAfter time.Duration) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		URL:        url,
+		Body:       body,
+		retryAfter: retryAfter,
+	}
+	var parsed apiErrorBody
+	if json.Unmarshal(body, &parsed) == nil {
+		apiErr.Status = parsed.Status
+		for _, e := range parsed.Errors {
+			apiErr.Errors = append(apiErr.Errors, e.Error)
+		}
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if len(e.Errors) > 0 {
+		return fmt.Sprintf("congress: %s: status %d: %s", e.URL, e.StatusCode, strings.Join(e.Errors, "; "))
+	}
+	return fmt.Sprintf("congress: %s: status %d", e.URL, e.StatusCode)
+}
+
+// Is lets errors.Is(err, ErrNotFound) (and friends) match an *APIError
+// based on its status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// IsRateLimited reports whether err is a rate-limit response from the API,
+// returning how long the API asked callers to wait before retrying.
+func IsRateLimited(err error) (retryAfter time.Duration, ok bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests {
+		return apiErr.retryAfter, true
+	}
+	return 0, false
+}
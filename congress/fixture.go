@@ -0,0 +1,99 @@
+package congress
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FixtureTransport is an http.RoundTripper that serves recorded JSON
+// responses from a directory instead of making real HTTP requests, keyed
+// by request URL. Point Client.HTTP at one (wrapped in an *http.Client) to
+// exercise this package's methods in tests without a live ProPublica API
+// key.
+type FixtureTransport struct {
+	// Dir is the directory holding fixture files, as written by
+	// RecordTransport.
+	Dir string
+}
+
+// RoundTrip returns the fixture recorded for req.URL, or an error if none
+// exists.
+func (f FixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := fixturePath(f.Dir, req.URL)
+	body, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("congress: no fixture for %s (looked in %s): %w", req.URL, path, err)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+// RecordTransport wraps another RoundTripper, saving every response body
+// to Dir (keyed by request URL) before returning it unchanged. Point a
+// Client.HTTP at one of these once, against the live API, to build a
+// fixture corpus; switch to FixtureTransport to replay it afterward.
+type RecordTransport struct {
+	// Dir is the directory fixture files are written to.
+	Dir string
+
+	// Next is the RoundTripper that issues the real request. If nil,
+	// http.DefaultTransport is used.
+	Next http.RoundTripper
+}
+
+// RoundTrip issues req via r.Next and records the response body to Dir
+// before returning it.
+func (r RecordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := r.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	path := fixturePath(r.Dir, req.URL)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(path, body, 0o644); err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// fixturePath maps a request URL to a stable file path under dir. Query
+// parameters (used by the search and geocoding endpoints) are folded into
+// the filename via a short hash so distinct queries don't collide.
+func fixturePath(dir string, u *url.URL) string {
+	name := strings.TrimPrefix(u.Path, "/")
+	if u.RawQuery != "" {
+		sum := sha1.Sum([]byte(u.RawQuery))
+		name = fmt.Sprintf("%s.%s", name, hex.EncodeToString(sum[:])[:8])
+	}
+	if filepath.Ext(name) == "" {
+		name += ".json"
+	}
+	return filepath.Join(dir, filepath.FromSlash(name))
+}
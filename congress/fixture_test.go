@@ -0,0 +1,555 @@
+package congress
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testRateLimiter is wide open, so fixture tests don't pay for
+// DefaultRateLimiter's real-world one-request-per-second throttling.
+func testRateLimiter() RateLimiter {
+	return NewTokenBucketLimiter(time.Nanosecond, 1000)
+}
+
+func testClient(t *testing.T) *Client {
+	t.Helper()
+	return &Client{
+		Endpoint:    "https://api.example.test",
+		Key:         "test-key",
+		HTTP:        &http.Client{Transport: FixtureTransport{Dir: "testdata"}},
+		RateLimiter: testRateLimiter(),
+	}
+}
+
+func TestGetMembersFixture(t *testing.T) {
+	c := testClient(t)
+	members, err := c.GetMembers(context.Background(), 118, "house")
+	if err != nil {
+		t.Fatalf("GetMembers: %v", err)
+	}
+	if len(members) != 1 {
+		t.Fatalf("expected 1 member, got %d", len(members))
+	}
+	if members[0].ID != "D000001" {
+		t.Errorf("expected member ID D000001, got %q", members[0].ID)
+	}
+	if members[0].Birth.Format("2006-01-02") != "1970-01-15" {
+		t.Errorf("expected parsed birth date 1970-01-15, got %v", members[0].Birth)
+	}
+}
+
+func TestGetMemberFixture(t *testing.T) {
+	c := testClient(t)
+	member, err := c.GetMember(context.Background(), "D000001")
+	if err != nil {
+		t.Fatalf("GetMember: %v", err)
+	}
+	if len(member.Roles) != 1 {
+		t.Fatalf("expected 1 role, got %d", len(member.Roles))
+	}
+	if member.Roles[0].District != "12" {
+		t.Errorf("expected district 12, got %q", member.Roles[0].District)
+	}
+}
+
+func TestGetBillFixture(t *testing.T) {
+	c := testClient(t)
+	bill, err := c.GetBill(context.Background(), 118, "hr1234")
+	if err != nil {
+		t.Fatalf("GetBill: %v", err)
+	}
+	if bill.Number != "H.R.1234" {
+		t.Errorf("expected number H.R.1234, got %q", bill.Number)
+	}
+}
+
+func TestGetCommitteesFixture(t *testing.T) {
+	c := testClient(t)
+	committees, err := c.GetCommittees(context.Background(), 118, "house")
+	if err != nil {
+		t.Fatalf("GetCommittees: %v", err)
+	}
+	if len(committees) != 1 || committees[0].ID != "HSAG" {
+		t.Fatalf("expected committee HSAG, got %+v", committees)
+	}
+}
+
+func TestGetCommitteeFixture(t *testing.T) {
+	c := testClient(t)
+	committee, err := c.GetCommittee(context.Background(), 118, "house", "HSAG")
+	if err != nil {
+		t.Fatalf("GetCommittee: %v", err)
+	}
+	if len(committee.Subcommittees) != 1 || committee.Subcommittees[0].ID != "AG14" {
+		t.Errorf("expected subcommittee AG14, got %+v", committee.Subcommittees)
+	}
+	if len(committee.Members) != 1 || committee.Members[0].ID != "D000001" {
+		t.Errorf("expected current member D000001, got %+v", committee.Members)
+	}
+}
+
+func TestGetBillCosponsorsFixture(t *testing.T) {
+	c := testClient(t)
+	cosponsors, err := c.GetBillCosponsors(context.Background(), 118, "hr1234")
+	if err != nil {
+		t.Fatalf("GetBillCosponsors: %v", err)
+	}
+	if len(cosponsors) != 1 || cosponsors[0].ID != "R000002" {
+		t.Fatalf("expected cosponsor R000002, got %+v", cosponsors)
+	}
+}
+
+func TestGetBillSubjectsFixture(t *testing.T) {
+	c := testClient(t)
+	subjects, err := c.GetBillSubjects(context.Background(), 118, "hr1234")
+	if err != nil {
+		t.Fatalf("GetBillSubjects: %v", err)
+	}
+	if len(subjects) != 2 || subjects[0].Name != "Agriculture and Food" {
+		t.Fatalf("expected 2 subjects, got %+v", subjects)
+	}
+}
+
+func TestGetRelatedBillsFixture(t *testing.T) {
+	c := testClient(t)
+	related, err := c.GetRelatedBills(context.Background(), 118, "hr1234")
+	if err != nil {
+		t.Fatalf("GetRelatedBills: %v", err)
+	}
+	if len(related) != 1 || related[0].ID != "s5678-118" {
+		t.Fatalf("expected related bill s5678-118, got %+v", related)
+	}
+}
+
+func TestGetRecentBillsFixture(t *testing.T) {
+	c := testClient(t)
+	bills, err := c.GetRecentBills(context.Background(), 118, "house", "introduced")
+	if err != nil {
+		t.Fatalf("GetRecentBills: %v", err)
+	}
+	if len(bills) != 1 || bills[0].Number != "H.R.1234" {
+		t.Fatalf("expected H.R.1234, got %+v", bills)
+	}
+}
+
+func TestSearchBillsFixture(t *testing.T) {
+	c := testClient(t)
+	bills, err := c.SearchBills(context.Background(), "health care & reform", BillSearchOptions{
+		Congress: 118,
+		Sort:     "date",
+		Dir:      "desc",
+	})
+	if err != nil {
+		t.Fatalf("SearchBills: %v", err)
+	}
+	if len(bills) != 1 || bills[0].ID != "hr1234-118" {
+		t.Fatalf("expected hr1234-118, got %+v", bills)
+	}
+}
+
+func TestGetStatementsByMemberFixture(t *testing.T) {
+	c := testClient(t)
+	statements, err := c.GetStatements(context.Background(), "D000001", "")
+	if err != nil {
+		t.Fatalf("GetStatements: %v", err)
+	}
+	if len(statements) != 1 || statements[0].Title != "Statement on the budget" {
+		t.Fatalf("expected 1 statement, got %+v", statements)
+	}
+}
+
+func TestGetStatementsByDateFixture(t *testing.T) {
+	c := testClient(t)
+	statements, err := c.GetStatements(context.Background(), "", "2021-01-01")
+	if err != nil {
+		t.Fatalf("GetStatements: %v", err)
+	}
+	if len(statements) != 2 {
+		t.Fatalf("expected 2 statements, got %+v", statements)
+	}
+}
+
+func TestGetRecentVotesFixture(t *testing.T) {
+	c := testClient(t)
+	votes, err := c.GetRecentVotes(context.Background(), 118, "house")
+	if err != nil {
+		t.Fatalf("GetRecentVotes: %v", err)
+	}
+	if len(votes) != 1 || votes[0].RollCall != 55 {
+		t.Fatalf("expected roll call 55, got %+v", votes)
+	}
+}
+
+func TestGetVoteFixture(t *testing.T) {
+	c := testClient(t)
+	vote, err := c.GetVote(context.Background(), 118, "house", 1, 55)
+	if err != nil {
+		t.Fatalf("GetVote: %v", err)
+	}
+	if len(vote.Positions) != 2 || vote.Positions[0].VotePosition != "Yes" {
+		t.Fatalf("expected 2 positions, got %+v", vote.Positions)
+	}
+}
+
+func TestGetMemberVotesFixture(t *testing.T) {
+	c := testClient(t)
+	votes, err := c.GetMemberVotes(context.Background(), "D000001")
+	if err != nil {
+		t.Fatalf("GetMemberVotes: %v", err)
+	}
+	if len(votes) != 1 || votes[0].BillID != "hr1234-118" {
+		t.Fatalf("expected 1 vote, got %+v", votes)
+	}
+}
+
+func TestGetChamberMembersByStateFixture(t *testing.T) {
+	c := testClient(t)
+	members, err := c.GetChamberMembersByState(context.Background(), "ny", "house")
+	if err != nil {
+		t.Fatalf("GetChamberMembersByState: %v", err)
+	}
+	if len(members) != 1 || members[0].ID != "D000001" {
+		t.Fatalf("expected member D000001, got %+v", members)
+	}
+}
+
+func TestGetMembersByStateFixture(t *testing.T) {
+	c := testClient(t)
+	members, err := c.GetMembersByState(context.Background(), "ny")
+	if err != nil {
+		t.Fatalf("GetMembersByState: %v", err)
+	}
+	if len(members) != 2 {
+		t.Fatalf("expected 1 house + 1 senate member, got %+v", members)
+	}
+}
+
+func TestGetChamberMembersByDistrictFixture(t *testing.T) {
+	c := testClient(t)
+	members, err := c.GetChamberMembersByDistrict(context.Background(), "ny", 12, "house")
+	if err != nil {
+		t.Fatalf("GetChamberMembersByDistrict: %v", err)
+	}
+	if len(members) != 1 || members[0].ID != "D000001" {
+		t.Fatalf("expected member D000001, got %+v", members)
+	}
+}
+
+func TestGetNewMembersFixture(t *testing.T) {
+	c := testClient(t)
+	members, err := c.GetNewMembers(context.Background())
+	if err != nil {
+		t.Fatalf("GetNewMembers: %v", err)
+	}
+	if len(members) != 1 || members[0].ID != "N000099" {
+		t.Fatalf("expected member N000099, got %+v", members)
+	}
+}
+
+func TestGetDepartingMembersFixture(t *testing.T) {
+	c := testClient(t)
+	members, err := c.GetDepartingMembers(context.Background(), 118, "house")
+	if err != nil {
+		t.Fatalf("GetDepartingMembers: %v", err)
+	}
+	if len(members) != 2 {
+		t.Fatalf("expected 1 house + 1 senate departing member, got %+v", members)
+	}
+	if !members[0].EndDate.IsZero() {
+		t.Errorf("expected empty end_date to parse as the zero Date, got %v", members[0].EndDate)
+	}
+	if members[1].EndDate.Format(time.RFC3339) != "2025-01-03T00:00:00-05:00" {
+		t.Errorf("expected RFC3339 end_date to parse, got %v", members[1].EndDate)
+	}
+}
+
+func TestGetMembersByAddressFixture(t *testing.T) {
+	c := testClient(t)
+	members, err := c.GetMembersByAddress(context.Background(), "100 Main St, Springfield, IL")
+	if err != nil {
+		t.Fatalf("GetMembersByAddress: %v", err)
+	}
+	if len(members) != 2 {
+		t.Fatalf("expected 1 house + 1 senate member, got %+v", members)
+	}
+}
+
+func TestGetMembersByLatLngFixture(t *testing.T) {
+	c := testClient(t)
+	members, err := c.GetMembersByLatLng(context.Background(), 40.730610, -73.935242)
+	if err != nil {
+		t.Fatalf("GetMembersByLatLng: %v", err)
+	}
+	if len(members) != 2 {
+		t.Fatalf("expected 1 house + 1 senate member, got %+v", members)
+	}
+}
+
+// TestGetMembersByLatLngAtLargeFixture exercises a state with a single,
+// at-large House seat, where the Census geographies layer reports CD "00".
+// ProPublica addresses that seat as district 0, not 1.
+func TestGetMembersByLatLngAtLargeFixture(t *testing.T) {
+	c := testClient(t)
+	members, err := c.GetMembersByLatLng(context.Background(), 43.075970, -107.290300)
+	if err != nil {
+		t.Fatalf("GetMembersByLatLng: %v", err)
+	}
+	if len(members) != 2 || members[0].ID != "W000001" || members[1].ID != "W000002" {
+		t.Fatalf("expected at-large house member + senator, got %+v", members)
+	}
+}
+
+func TestMemberQueryFixture(t *testing.T) {
+	c := testClient(t)
+	it, err := c.Members().Congress(118).Chamber("house").Party("D").Iter(context.Background())
+	if err != nil {
+		t.Fatalf("Members().Iter: %v", err)
+	}
+	var seen int
+	for it.Next() {
+		seen++
+		if it.Member().ID != "D000001" {
+			t.Errorf("expected member D000001, got %q", it.Member().ID)
+		}
+	}
+	if seen != 1 {
+		t.Fatalf("expected 1 matching member, got %d", seen)
+	}
+}
+
+func TestAPIErrorSentinels(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   error
+	}{
+		{http.StatusNotFound, `{"status":"ERROR","errors":[{"error":"record not found"}]}`, ErrNotFound},
+		{http.StatusUnauthorized, `{"status":"ERROR","errors":[{"error":"API key missing"}]}`, ErrUnauthorized},
+		{http.StatusTooManyRequests, `{"status":"ERROR","errors":[{"error":"rate limit exceeded"}]}`, ErrRateLimited},
+	}
+	for _, tt := range tests {
+		client := &Client{
+			Endpoint: "https://api.example.test",
+			Key:      "test-key",
+			HTTP: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: tt.status,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       ioutil.NopCloser(strings.NewReader(tt.body)),
+					Request:    req,
+				}, nil
+			})},
+			RateLimiter: testRateLimiter(),
+			RetryPolicy: RetryPolicy{MaxRetries: 0, BaseDelay: time.Microsecond},
+		}
+		_, err := client.GetMember(context.Background(), "D000001")
+		if !errors.Is(err, tt.want) {
+			t.Errorf("status %d: expected errors.Is to match %v, got %v", tt.status, tt.want, err)
+		}
+	}
+}
+
+func TestLRUCache(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("a", CacheEntry{Body: []byte("a")})
+	c.Set("b", CacheEntry{Body: []byte("b")})
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected \"a\" to still be cached")
+	}
+	// "a" was just touched by the Get above, so "b" is now the
+	// least-recently-used entry and should be evicted.
+	c.Set("c", CacheEntry{Body: []byte("c")})
+	if _, ok := c.Get("b"); ok {
+		t.Error("expected \"b\" to have been evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Error("expected \"a\" to survive eviction")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Error("expected \"c\" to be cached")
+	}
+}
+
+func TestTokenBucketLimiter(t *testing.T) {
+	l := NewTokenBucketLimiter(50*time.Millisecond, 2)
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Millisecond {
+		t.Errorf("expected burst of 2 to not block, took %v", elapsed)
+	}
+
+	start = time.Now()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("expected third request to wait for a refill, took %v", elapsed)
+	}
+}
+
+func TestGetRetriesOnServerErrorThenSucceeds(t *testing.T) {
+	var attempts int
+	client := &Client{
+		Endpoint: "https://api.example.test",
+		Key:      "test-key",
+		HTTP: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts < 3 {
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     http.Header{},
+					Body:       ioutil.NopCloser(strings.NewReader(`{"status":"ERROR"}`)),
+					Request:    req,
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"status":"OK","results":[{"member_id":"D000001"}]}`)),
+				Request:    req,
+			}, nil
+		})},
+		RateLimiter: testRateLimiter(),
+		RetryPolicy: RetryPolicy{MaxRetries: 3, BaseDelay: time.Microsecond},
+	}
+	member, err := client.GetMember(context.Background(), "D000001")
+	if err != nil {
+		t.Fatalf("GetMember: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+	if member.ID != "D000001" {
+		t.Errorf("expected member D000001, got %q", member.ID)
+	}
+}
+
+func TestGetHonorsRetryAfter(t *testing.T) {
+	var attempts int
+	client := &Client{
+		Endpoint: "https://api.example.test",
+		Key:      "test-key",
+		HTTP: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts == 1 {
+				return &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{"Retry-After": []string{"1"}},
+					Body:       ioutil.NopCloser(strings.NewReader(`{"status":"ERROR"}`)),
+					Request:    req,
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"status":"OK","results":[{"member_id":"D000001"}]}`)),
+				Request:    req,
+			}, nil
+		})},
+		RateLimiter: testRateLimiter(),
+		// BaseDelay is set absurdly high so the test would time out if the
+		// Retry-After header weren't overriding it.
+		RetryPolicy: RetryPolicy{MaxRetries: 1, BaseDelay: time.Hour},
+	}
+	start := time.Now()
+	if _, err := client.GetMember(context.Background(), "D000001"); err != nil {
+		t.Fatalf("GetMember: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the 1s Retry-After to be honored instead of the 1h BaseDelay, took %v", elapsed)
+	}
+}
+
+func TestIsRateLimited(t *testing.T) {
+	client := &Client{
+		Endpoint: "https://api.example.test",
+		Key:      "test-key",
+		HTTP: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": []string{"30"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"status":"ERROR"}`)),
+				Request:    req,
+			}, nil
+		})},
+		RateLimiter: testRateLimiter(),
+		RetryPolicy: RetryPolicy{MaxRetries: 0, BaseDelay: time.Microsecond},
+	}
+	_, err := client.GetMember(context.Background(), "D000001")
+	retryAfter, ok := IsRateLimited(err)
+	if !ok {
+		t.Fatalf("expected IsRateLimited to recognize %v", err)
+	}
+	if retryAfter != 30*time.Second {
+		t.Errorf("expected retryAfter of 30s, got %v", retryAfter)
+	}
+
+	if _, ok := IsRateLimited(errors.New("unrelated")); ok {
+		t.Error("expected IsRateLimited to reject an unrelated error")
+	}
+}
+
+func TestFixtureTransportMissingFixture(t *testing.T) {
+	c := testClient(t)
+	_, err := c.GetMember(context.Background(), "NOBODY")
+	if err == nil {
+		t.Fatal("expected an error for a missing fixture, got nil")
+	}
+}
+
+func TestRecordTransport(t *testing.T) {
+	dir := t.TempDir()
+	fixtureServer := http.NewServeMux()
+	fixtureServer.HandleFunc("/118/house/members.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"OK","results":[{"members":[{"id":"R000001"}]}]}`))
+	})
+
+	client := &Client{
+		Endpoint: "https://api.example.test",
+		Key:      "test-key",
+		HTTP: &http.Client{Transport: RecordTransport{
+			Dir: dir,
+			Next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				rec := httptest.NewRecorder()
+				fixtureServer.ServeHTTP(rec, req)
+				resp := rec.Result()
+				resp.Request = req
+				return resp, nil
+			}),
+		}},
+		RateLimiter: testRateLimiter(),
+	}
+
+	members, err := client.GetMembers(context.Background(), 118, "house")
+	if err != nil {
+		t.Fatalf("GetMembers: %v", err)
+	}
+	if len(members) != 1 || members[0].ID != "R000001" {
+		t.Fatalf("expected member R000001, got %+v", members)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "118", "house", "members.json")); err != nil {
+		t.Errorf("expected RecordTransport to write a fixture file: %v", err)
+	}
+}
+
+// roundTripFunc adapts a function to an http.RoundTripper, so the test
+// above can stand in for a real upstream server.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,271 @@
+package congress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// GeocoderProvider turns a free-form address into a latitude/longitude
+// pair. It exists so callers can swap in their own geocoding service
+// (Census Geocoder, Google Civic Information, etc.) instead of the
+// default implementation.
+type GeocoderProvider interface {
+	Geocode(ctx context.Context, address string) (lat, lng float64, err error)
+}
+
+// DistrictResolver turns a latitude/longitude pair into the OCD-ID
+// (Open Civic Data division identifier) of the congressional district
+// that point falls within, i.e. "ocd-division/country:us/state:ny/cd:12".
+type DistrictResolver interface {
+	Resolve(ctx context.Context, lat, lng float64) (ocdID string, err error)
+}
+
+// CensusGeocoder is the default GeocoderProvider, backed by the Census
+// Bureau's public geocoding service.
+type CensusGeocoder struct {
+	// Endpoint defaults to the Census Geocoder's "onelineaddress" locations
+	// endpoint if left blank.
+	Endpoint string
+
+	// HTTP issues the underlying HTTP request. If nil, http.DefaultClient
+	// is used. GetMembersByAddress passes the Client's own HTTP here so the
+	// geocoding request shares its transport.
+	HTTP HTTPDoer
+}
+
+const censusGeocoderEndpoint = "https://geocoding.geo.census.gov/geocoder/locations/onelineaddress"
+
+type censusGeocoderResponse struct {
+	Result struct {
+		AddressMatches []struct {
+			Coordinates struct {
+				X float64 `json:"x"` // longitude
+				Y float64 `json:"y"` // latitude
+			} `json:"coordinates"`
+		} `json:"addressMatches"`
+	} `json:"result"`
+}
+
+// Geocode resolves address to a latitude/longitude pair using the Census
+// Geocoder.
+func (g CensusGeocoder) Geocode(ctx context.Context, address string) (lat, lng float64, err error) {
+	endpoint := g.Endpoint
+	if endpoint == "" {
+		endpoint = censusGeocoderEndpoint
+	}
+	doer := g.HTTP
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s?address=%s&benchmark=Public_AR_Current&format=json", endpoint, url.QueryEscape(address)), nil)
+	if err != nil {
+		return
+	}
+	resp, err := doer.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	var unmarshaled censusGeocoderResponse
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(body, &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Result.AddressMatches) == 0 {
+		err = fmt.Errorf("congress: no geocoder match for address %q", address)
+		return
+	}
+	match := unmarshaled.Result.AddressMatches[0]
+	lat = match.Coordinates.Y
+	lng = match.Coordinates.X
+	return
+}
+
+// CensusDistrictResolver is the default DistrictResolver, backed by the
+// Census Bureau's geocoder "Congressional Districts" layer.
+type CensusDistrictResolver struct {
+	// Endpoint defaults to the Census Geocoder's "coordinates" geographies
+	// endpoint if left blank.
+	Endpoint string
+
+	// Benchmark and Vintage select which Census geography snapshot to query
+	// against. They default to the current public benchmark/vintage.
+	Benchmark string
+	Vintage   string
+
+	// HTTP issues the underlying HTTP request. If nil, http.DefaultClient
+	// is used. GetMembersByLatLng passes the Client's own HTTP here so the
+	// geocoding request shares its transport.
+	HTTP HTTPDoer
+}
+
+const censusGeographiesEndpoint = "https://geocoding.geo.census.gov/geocoder/geographies/coordinates"
+
+type censusGeographiesResponse struct {
+	Result struct {
+		Geographies struct {
+			CongressionalDistricts []struct {
+				State          string `json:"STATE"`
+				CD             string `json:"CD"`
+				BasenameAbbrev string `json:"STUSAB"`
+			} `json:"119th Congressional Districts"`
+		} `json:"geographies"`
+	} `json:"result"`
+}
+
+// Resolve resolves a latitude/longitude pair to the OCD-ID of the
+// congressional district containing that point.
+func (r CensusDistrictResolver) Resolve(ctx context.Context, lat, lng float64) (ocdID string, err error) {
+	endpoint := r.Endpoint
+	if endpoint == "" {
+		endpoint = censusGeographiesEndpoint
+	}
+	benchmark := r.Benchmark
+	if benchmark == "" {
+		benchmark = "Public_AR_Current"
+	}
+	vintage := r.Vintage
+	if vintage == "" {
+		vintage = "Current_Current"
+	}
+	doer := r.HTTP
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s?x=%f&y=%f&benchmark=%s&vintage=%s&layers=all&format=json", endpoint, lng, lat, benchmark, vintage), nil)
+	if err != nil {
+		return
+	}
+	resp, err := doer.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	var unmarshaled censusGeographiesResponse
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(body, &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Result.Geographies.CongressionalDistricts) == 0 {
+		err = fmt.Errorf("congress: no congressional district found for %f,%f", lat, lng)
+		return
+	}
+	district := unmarshaled.Result.Geographies.CongressionalDistricts[0]
+	state := strings.ToLower(district.BasenameAbbrev)
+	cdNum, convErr := strconv.Atoi(district.CD)
+	if convErr != nil {
+		err = fmt.Errorf("congress: could not parse congressional district %q", district.CD)
+		return
+	}
+	// Census represents at-large House seats as CD "00"; ProPublica
+	// addresses those members as district 0, not 1.
+	ocdID = fmt.Sprintf("ocd-division/country:us/state:%s/cd:%d", state, cdNum)
+	return
+}
+
+// parseDistrictOCD splits an OCD-ID of the form
+// "ocd-division/country:us/state:xx/cd:n" into its state abbreviation and
+// district number.
+func parseDistrictOCD(ocdID string) (state string, district int, err error) {
+	parts := strings.Split(ocdID, "/")
+	for _, part := range parts {
+		if strings.HasPrefix(part, "state:") {
+			state = strings.TrimPrefix(part, "state:")
+		}
+		if strings.HasPrefix(part, "cd:") {
+			district, err = strconv.Atoi(strings.TrimPrefix(part, "cd:"))
+			if err != nil {
+				return
+			}
+		}
+	}
+	if state == "" {
+		err = fmt.Errorf("congress: could not parse state from OCD-ID %q", ocdID)
+	}
+	return
+}
+
+// GetMembersByLatLng fetches the senators and House member representing
+// the congressional district a latitude/longitude pair falls within. It
+// uses c.Resolver (falling back to CensusDistrictResolver, wired to c.HTTP
+// and c.RateLimiter) to turn the coordinates into an OCD-ID, then dispatches
+// to GetChamberMembersByDistrict and GetChamberMembersByState. The Census
+// geocoder is a separate, unauthenticated service from the ProPublica API,
+// so c.Cache and c.RetryPolicy (which govern ProPublica request retries and
+// caching) don't apply to it.
+func (c *Client) GetMembersByLatLng(ctx context.Context, lat, lng float64) (members []MemberSearch, err error) {
+	resolver := c.Resolver
+	if resolver == nil {
+		resolver = CensusDistrictResolver{HTTP: c.HTTP}
+	}
+	limiter := c.RateLimiter
+	if limiter == nil {
+		limiter = DefaultRateLimiter
+	}
+	if err = limiter.Wait(ctx); err != nil {
+		return
+	}
+	ocdID, err := resolver.Resolve(ctx, lat, lng)
+	if err != nil {
+		return
+	}
+	return c.getMembersByDistrictOCD(ctx, ocdID)
+}
+
+// GetMembersByAddress fetches the senators and House member representing
+// the congressional district a street address falls within. It uses
+// c.Geocoder (falling back to CensusGeocoder, wired to c.HTTP and
+// c.RateLimiter) to resolve the address to coordinates, then behaves like
+// GetMembersByLatLng.
+func (c *Client) GetMembersByAddress(ctx context.Context, address string) (members []MemberSearch, err error) {
+	geocoder := c.Geocoder
+	if geocoder == nil {
+		geocoder = CensusGeocoder{HTTP: c.HTTP}
+	}
+	limiter := c.RateLimiter
+	if limiter == nil {
+		limiter = DefaultRateLimiter
+	}
+	if err = limiter.Wait(ctx); err != nil {
+		return
+	}
+	lat, lng, err := geocoder.Geocode(ctx, address)
+	if err != nil {
+		return
+	}
+	return c.GetMembersByLatLng(ctx, lat, lng)
+}
+
+// getMembersByDistrictOCD fetches a district's single House member and both
+// of its state's senators, given an OCD-ID.
+func (c *Client) getMembersByDistrictOCD(ctx context.Context, ocdID string) (members []MemberSearch, err error) {
+	state, district, err := parseDistrictOCD(ocdID)
+	if err != nil {
+		return
+	}
+	house, err := c.GetChamberMembersByDistrict(ctx, state, district, "house")
+	if err != nil {
+		return
+	}
+	members = append(members, house...)
+	senate, err := c.GetChamberMembersByState(ctx, state, "senate")
+	if err != nil {
+		return
+	}
+	members = append(members, senate...)
+	return
+}
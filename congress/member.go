@@ -1,10 +1,8 @@
 package congress
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 )
 
 // Member holds the data of a member of Congress that is sent
@@ -51,7 +49,7 @@ type TrackingIDs struct {
 type MemberSummary struct {
 	Member
 	ID          string `json:"id"`
-	Birth       string `json:"date_of_birth"`
+	Birth       Date   `json:"date_of_birth"`
 	State       string `json:"state,omitempty"`
 	Title       string `json:"title"`
 	ShortTitle  string `json:"short_title"`
@@ -72,7 +70,7 @@ type MemberSummary struct {
 	TotalVotes     int     `json:"total_votes,omitempty"`
 	MissedVotes    int     `json:"missed_votes,omitempty"`
 	PresentVotes   int     `json:"present_votes,omitempty"`
-	NextElection   string  `json:"next_election,omitempty"`
+	NextElection   Date    `json:"next_election,omitempty"`
 	Seniority      string  `json:"seniority,omitempty"`
 	MissedVotesPct float32 `json:"missed_votes_pct,omitempty"`
 	VotesWithParty float32 `json:"votes_with_party_pct,omitempty"`
@@ -97,14 +95,14 @@ type MemberSummary struct {
 type MemberDetails struct {
 	Member
 	ID             string       `json:"member_id"` // NOTE: JSON key is different from MemberSummary
-	Birth          string       `json:"date_of_birth"`
+	Birth          Date         `json:"date_of_birth"`
 	Gender         string       `json:"gender"`
 	Party          string       `json:"current_party"` // NOTE: JSON key is different from MemberSummary
 	State          string       `json:"state,omitempty"`
 	InOffice       bool         `json:"in_office"`
 	TimesTopics    string       `json:"times_topics_url,omitempty"`
 	TimesTag       string       `json:"times_tag,omitempty"`
-	MostRecentVote string       `json:"most_recent_vote,omitempty"`
+	MostRecentVote Date         `json:"most_recent_vote,omitempty"`
 	Roles          []MemberRole `json:"roles,omitempty"`
 	TrackingIDs
 }
@@ -137,11 +135,11 @@ type MemberInTransition struct {
 	Chamber    string `json:"chamber"`
 	State      string `json:"state"`
 	District   string `json:"district,omitempty"`
-	StartDate  string `json:"start_date"`
+	StartDate  Date   `json:"start_date"`
 	URL        string `json:"api_uri"`
 
 	// Fields only included for departing members:
-	EndDate string `json:"end_date"`
+	EndDate Date   `json:"end_date"`
 	Status  string `json:"status"`
 	Note    string `json:"note"`
 }
@@ -161,8 +159,8 @@ type MemberRole struct {
 	District   string `json:"district,omitempty"`
 	AtLarge    bool   `json:"at_large"`
 	OCD        string `json:"ocd_id,omitempty"`
-	StartDate  string `json:"start_date"`
-	EndDate    string `json:"end_date"`
+	StartDate  Date   `json:"start_date"`
+	EndDate    Date   `json:"end_date"`
 	Office     string `json:"office"`
 	Phone      string `json:"phone"`
 	Fax        string `json:"fax"`
@@ -194,8 +192,8 @@ type MemberCommittee struct {
 
 	Title     string `json:"member"`
 	PartyRank int    `json:"rank_in_party"`
-	BeginDate string `json:"begin_date"`
-	EndDate   string `json:"end_date"`
+	BeginDate Date   `json:"begin_date"`
+	EndDate   Date   `json:"end_date"`
 }
 
 // MemberSubcommittee is information about a politician's role on a subcommittee
@@ -225,23 +223,9 @@ type getMembersResults struct {
 
 // GetMembers fetches a list of members of a defined chamber of Congress ("house" or "senate")
 // in a particular congress (i.e. 115).
-func (c *Client) GetMembers(congress int, chamber string) (members []MemberSummary, err error) {
-	client := &http.Client{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%d/%s/members.json", c.Endpoint, congress, chamber), nil)
-	if err != nil {
-		return
-	}
-	req.Header.Add("X-API-Key", c.Key)
-	resp, err := client.Do(req)
-	if err != nil {
-		return
-	}
+func (c *Client) GetMembers(ctx context.Context, congress int, chamber string) (members []MemberSummary, err error) {
 	var unmarshaled getMembersResponse
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return
-	}
-	err = json.Unmarshal(body, &unmarshaled)
+	err = c.get(ctx, fmt.Sprintf("%s/%d/%s/members.json", c.Endpoint, congress, chamber), &unmarshaled)
 	if err != nil {
 		return
 	}
@@ -261,23 +245,9 @@ type getMemberResponse struct {
 
 // GetMember fetches detailed information about a single politician spanning
 // their congressional career
-func (c *Client) GetMember(id string) (member MemberDetails, err error) {
-	client := &http.Client{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s.json", c.Endpoint, id), nil)
-	if err != nil {
-		return
-	}
-	req.Header.Add("X-API-Key", c.Key)
-	resp, err := client.Do(req)
-	if err != nil {
-		return
-	}
+func (c *Client) GetMember(ctx context.Context, id string) (member MemberDetails, err error) {
 	var unmarshaled getMemberResponse
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return
-	}
-	err = json.Unmarshal(body, &unmarshaled)
+	err = c.get(ctx, fmt.Sprintf("%s/members/%s.json", c.Endpoint, id), &unmarshaled)
 	if err != nil {
 		return
 	}
@@ -297,39 +267,24 @@ type getMembersByStateResponse struct {
 
 // GetChamberMembersByState fetches basic information about the congressional delegation
 // of a single chamber for a single state. ("state" param is case-insensitive two-character abbreviation.)
-func (c *Client) GetChamberMembersByState(state, chamber string) (members []MemberSearch, err error) {
-	client := &http.Client{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s/%s/current.json", c.Endpoint, chamber, state), nil)
-	if err != nil {
-		return
-	}
-	req.Header.Add("X-API-Key", c.Key)
-	resp, err := client.Do(req)
-	if err != nil {
-		return
-	}
+func (c *Client) GetChamberMembersByState(ctx context.Context, state, chamber string) (members []MemberSearch, err error) {
 	var unmarshaled getMembersByStateResponse
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return
-	}
-	err = json.Unmarshal(body, &unmarshaled)
+	err = c.get(ctx, fmt.Sprintf("%s/members/%s/%s/current.json", c.Endpoint, chamber, state), &unmarshaled)
 	if err != nil {
 		return
 	}
 	members = unmarshaled.Results
-
 	return
 }
 
 // GetMembersByState fetches basic information about the entire congressional
 // delegation for a single state
-func (c *Client) GetMembersByState(state string) (members []MemberSearch, err error) {
-	members, err = c.GetChamberMembersByState(state, "house")
+func (c *Client) GetMembersByState(ctx context.Context, state string) (members []MemberSearch, err error) {
+	members, err = c.GetChamberMembersByState(ctx, state, "house")
 	if err != nil {
 		return
 	}
-	senate, err := c.GetChamberMembersByState(state, "senate")
+	senate, err := c.GetChamberMembersByState(ctx, state, "senate")
 	if err != nil {
 		return
 	}
@@ -339,28 +294,13 @@ func (c *Client) GetMembersByState(state string) (members []MemberSearch, err er
 
 // GetChamberMembersByDistrict fetches basic information about the congressional delegation
 // of a single chamber for a single district of a state.
-func (c *Client) GetChamberMembersByDistrict(state string, district int, chamber string) (members []MemberSearch, err error) {
-	client := &http.Client{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s/%s/%d/current.json", c.Endpoint, chamber, state, district), nil)
-	if err != nil {
-		return
-	}
-	req.Header.Add("X-API-Key", c.Key)
-	resp, err := client.Do(req)
-	if err != nil {
-		return
-	}
+func (c *Client) GetChamberMembersByDistrict(ctx context.Context, state string, district int, chamber string) (members []MemberSearch, err error) {
 	var unmarshaled getMembersByStateResponse
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return
-	}
-	err = json.Unmarshal(body, &unmarshaled)
+	err = c.get(ctx, fmt.Sprintf("%s/members/%s/%s/%d/current.json", c.Endpoint, chamber, state, district), &unmarshaled)
 	if err != nil {
 		return
 	}
 	members = unmarshaled.Results
-
 	return
 }
 
@@ -388,23 +328,9 @@ type getMembersInTransitionResults struct {
 
 // GetNewMembers fetches basic information about the first-time members
 // of either chamber.
-func (c *Client) GetNewMembers() (members []MemberInTransition, err error) {
-	client := &http.Client{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/new.json", c.Endpoint), nil)
-	if err != nil {
-		return
-	}
-	req.Header.Add("X-API-Key", c.Key)
-	resp, err := client.Do(req)
-	if err != nil {
-		return
-	}
+func (c *Client) GetNewMembers(ctx context.Context) (members []MemberInTransition, err error) {
 	var unmarshaled getMembersInTransitionResponse
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return
-	}
-	err = json.Unmarshal(body, &unmarshaled)
+	err = c.get(ctx, fmt.Sprintf("%s/members/new.json", c.Endpoint), &unmarshaled)
 	if err != nil {
 		return
 	}
@@ -416,23 +342,9 @@ func (c *Client) GetNewMembers() (members []MemberInTransition, err error) {
 
 // GetDepartingMembers fetches basic information about the outgoing members
 // of both chambers for a particular Congress.
-func (c *Client) GetDepartingMembers(congress int, chamber string) (members []MemberInTransition, err error) {
-	client := &http.Client{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%d/%s/members/leaving.json", c.Endpoint, congress, chamber), nil)
-	if err != nil {
-		return
-	}
-	req.Header.Add("X-API-Key", c.Key)
-	resp, err := client.Do(req)
-	if err != nil {
-		return
-	}
+func (c *Client) GetDepartingMembers(ctx context.Context, congress int, chamber string) (members []MemberInTransition, err error) {
 	var unmarshaled getMembersInTransitionResponse
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return
-	}
-	err = json.Unmarshal(body, &unmarshaled)
+	err = c.get(ctx, fmt.Sprintf("%s/%d/%s/members/leaving.json", c.Endpoint, congress, chamber), &unmarshaled)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,179 @@
+package congress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MemberQuery builds a filtered query across the members of a single
+// chamber of a single congress. ProPublica itself only lets callers filter
+// by state or district, so MemberQuery fetches the full chamber roster via
+// GetMembers and applies every filter (state, party, in-office status,
+// seniority, DW-Nominate range) client-side. This avoids a
+// combinatorial explosion of GetChamberMembersByStateAndParty-style
+// helpers for every filter combination.
+type MemberQuery struct {
+	client *Client
+
+	congress int
+	chamber  string
+	state    string
+	party    string
+	inOffice *bool
+
+	minSeniority int
+
+	haveDWNominateRange bool
+	dwNominateMin       float32
+	dwNominateMax       float32
+
+	fields []string
+}
+
+// Members starts a new MemberQuery against c.
+func (c *Client) Members() *MemberQuery {
+	return &MemberQuery{client: c}
+}
+
+// Congress restricts the query to a single congress (i.e. 118). Required.
+func (q *MemberQuery) Congress(congress int) *MemberQuery {
+	q.congress = congress
+	return q
+}
+
+// Chamber restricts the query to "house" or "senate". Required.
+func (q *MemberQuery) Chamber(chamber string) *MemberQuery {
+	q.chamber = chamber
+	return q
+}
+
+// State restricts the query to members representing a single two-letter
+// state abbreviation.
+func (q *MemberQuery) State(state string) *MemberQuery {
+	q.state = state
+	return q
+}
+
+// Party restricts the query to a single party ("D", "R", or "I").
+func (q *MemberQuery) Party(party string) *MemberQuery {
+	q.party = party
+	return q
+}
+
+// InOffice restricts the query to members who are (or aren't) currently
+// serving.
+func (q *MemberQuery) InOffice(inOffice bool) *MemberQuery {
+	q.inOffice = &inOffice
+	return q
+}
+
+// MinSeniority restricts the query to members who have served at least
+// minYears years in the chamber.
+func (q *MemberQuery) MinSeniority(minYears int) *MemberQuery {
+	q.minSeniority = minYears
+	return q
+}
+
+// DWNominateRange restricts the query to members whose DW-Nominate
+// ideological score falls within [min, max].
+func (q *MemberQuery) DWNominateRange(min, max float32) *MemberQuery {
+	q.haveDWNominateRange = true
+	q.dwNominateMin = min
+	q.dwNominateMax = max
+	return q
+}
+
+// Fields narrows the field set returned by MemberIterator.Raw to the
+// given JSON field names. It has no effect on MemberIterator.Member, which
+// always returns the complete MemberSummary.
+func (q *MemberQuery) Fields(fields ...string) *MemberQuery {
+	q.fields = fields
+	return q
+}
+
+// Iter runs the query and returns an iterator over the matching members.
+func (q *MemberQuery) Iter(ctx context.Context) (*MemberIterator, error) {
+	if q.congress == 0 || q.chamber == "" {
+		return nil, fmt.Errorf("congress: MemberQuery requires both Congress() and Chamber()")
+	}
+
+	members, err := q.client.GetMembers(ctx, q.congress, q.chamber)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]MemberSummary, 0, len(members))
+	for _, m := range members {
+		if q.state != "" && !strings.EqualFold(m.State, q.state) {
+			continue
+		}
+		if q.party != "" && !strings.EqualFold(m.Party, q.party) {
+			continue
+		}
+		if q.inOffice != nil && m.InOffice != *q.inOffice {
+			continue
+		}
+		if q.minSeniority > 0 {
+			years, convErr := strconv.Atoi(m.Seniority)
+			if convErr != nil || years < q.minSeniority {
+				continue
+			}
+		}
+		if q.haveDWNominateRange && (m.DWNominate < q.dwNominateMin || m.DWNominate > q.dwNominateMax) {
+			continue
+		}
+		filtered = append(filtered, m)
+	}
+
+	return &MemberIterator{members: filtered, fields: q.fields}, nil
+}
+
+// MemberIterator streams the members matched by a MemberQuery, one at a
+// time via Next.
+type MemberIterator struct {
+	members []MemberSummary
+	fields  []string
+	idx     int
+}
+
+// Next advances the iterator and reports whether a member is available.
+func (it *MemberIterator) Next() bool {
+	if it.idx >= len(it.members) {
+		return false
+	}
+	it.idx++
+	return true
+}
+
+// Member returns the current member. It must only be called after a call
+// to Next that returned true.
+func (it *MemberIterator) Member() MemberSummary {
+	return it.members[it.idx-1]
+}
+
+// Raw returns the current member narrowed to the field names passed to
+// MemberQuery.Fields, keyed by their JSON field names. If Fields was never
+// called, every field is included.
+func (it *MemberIterator) Raw() (map[string]interface{}, error) {
+	body, err := json.Marshal(it.members[it.idx-1])
+	if err != nil {
+		return nil, err
+	}
+	var full map[string]interface{}
+	if err := json.Unmarshal(body, &full); err != nil {
+		return nil, err
+	}
+	if len(it.fields) == 0 {
+		return full, nil
+	}
+	narrowed := make(map[string]interface{}, len(it.fields))
+	for _, f := range it.fields {
+		if v, ok := full[f]; ok {
+			narrowed[f] = v
+		}
+	}
+	return narrowed, nil
+}
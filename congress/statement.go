@@ -0,0 +1,43 @@
+package congress
+
+import (
+	"context"
+	"fmt"
+)
+
+// Statement is a single floor statement, press release, or op-ed
+// published by a member of Congress.
+type Statement struct {
+	MemberID string `json:"member_id"`
+	Chamber  string `json:"chamber,omitempty"`
+	Date     string `json:"date"`
+	Title    string `json:"title"`
+	URL      string `json:"url,omitempty"`
+}
+
+// getStatementsResponse is the format of the response received from the
+// Congress API "get statements" endpoints.
+type getStatementsResponse struct {
+	Status    string      `json:"status"`
+	Copyright string      `json:"copyright"`
+	Results   []Statement `json:"results"`
+}
+
+// GetStatements fetches floor statements for a single member of Congress,
+// identified by memberID. If memberID is empty, date is used instead to
+// fetch every statement published on that date (formatted "2006-01-02").
+func (c *Client) GetStatements(ctx context.Context, memberID, date string) (statements []Statement, err error) {
+	var url string
+	if memberID != "" {
+		url = fmt.Sprintf("%s/statements/members/%s.json", c.Endpoint, memberID)
+	} else {
+		url = fmt.Sprintf("%s/statements/%s.json", c.Endpoint, date)
+	}
+	var unmarshaled getStatementsResponse
+	err = c.get(ctx, url, &unmarshaled)
+	if err != nil {
+		return
+	}
+	statements = unmarshaled.Results
+	return
+}
@@ -0,0 +1,334 @@
+package congress
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HTTPDoer is the subset of *http.Client that Client needs to issue
+// requests. Callers can supply their own implementation (for instrumentation,
+// custom transports, request signing, etc.) via Client.HTTP. If left nil,
+// http.DefaultClient is used.
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RateLimiter throttles outgoing requests. Wait blocks until the caller is
+// clear to send another request, or ctx is done.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// DefaultRateLimiter throttles to roughly ProPublica's recommended one
+// request per second. It's used by Client whenever RateLimiter is left nil.
+var DefaultRateLimiter RateLimiter = NewTokenBucketLimiter(time.Second, 1)
+
+// TokenBucketLimiter is a RateLimiter backed by a simple token bucket. The
+// zero value is not usable; construct one with NewTokenBucketLimiter.
+type TokenBucketLimiter struct {
+	mu       sync.Mutex
+	rate     time.Duration
+	burst    int
+	tokens   int
+	lastFill time.Time
+}
+
+// NewTokenBucketLimiter creates a RateLimiter that allows up to burst
+// requests immediately, then refills one token every rate until burst
+// tokens have accumulated again. ProPublica recommends staying near one
+// request per second; its separate 5000 requests/day limit is not
+// enforced by the limiter.
+func NewTokenBucketLimiter(rate time.Duration, burst int) *TokenBucketLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucketLimiter{
+		rate:     rate,
+		burst:    burst,
+		tokens:   burst,
+		lastFill: time.Now(),
+	}
+}
+
+// Wait blocks until a token is available, or ctx is done.
+func (t *TokenBucketLimiter) Wait(ctx context.Context) error {
+	for {
+		t.mu.Lock()
+		elapsed := time.Since(t.lastFill)
+		if refill := int(elapsed / t.rate); refill > 0 {
+			t.tokens += refill
+			if t.tokens > t.burst {
+				t.tokens = t.burst
+			}
+			t.lastFill = t.lastFill.Add(time.Duration(refill) * t.rate)
+		}
+		if t.tokens > 0 {
+			t.tokens--
+			t.mu.Unlock()
+			return nil
+		}
+		wait := t.rate - elapsed%t.rate
+		t.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// CacheEntry is a single cached HTTP response body, along with the
+// validators needed to revalidate it and the freshness window within
+// which get can return it without making a request at all.
+type CacheEntry struct {
+	Body []byte
+	ETag string
+
+	// StoredAt is when the entry was cached.
+	StoredAt time.Time
+	// MaxAge is how long after StoredAt the entry stays fresh, taken from
+	// the response's Cache-Control max-age if it sent one, or Client's
+	// cache TTL otherwise.
+	MaxAge time.Duration
+}
+
+// fresh reports whether e was stored recently enough to satisfy a request
+// without revalidating it against the API.
+func (e CacheEntry) fresh() bool {
+	return e.MaxAge > 0 && time.Since(e.StoredAt) < e.MaxAge
+}
+
+// DefaultCacheTTL is the freshness window applied when Client.CacheTTL is
+// unset and the response didn't send its own Cache-Control max-age.
+var DefaultCacheTTL = 5 * time.Minute
+
+// parseMaxAge reads the max-age directive out of a Cache-Control header, if
+// present.
+func parseMaxAge(h http.Header) (time.Duration, bool) {
+	for _, directive := range strings.Split(h.Get("Cache-Control"), ",") {
+		directive = strings.TrimSpace(directive)
+		if !strings.HasPrefix(directive, "max-age=") {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+		if err != nil {
+			continue
+		}
+		return time.Duration(n) * time.Second, true
+	}
+	return 0, false
+}
+
+// ResponseCache caches HTTP response bodies, keyed by request URL, so
+// repeated reads don't count against ProPublica's daily request limit.
+type ResponseCache interface {
+	// Get returns a previously stored response for key, if any.
+	Get(key string) (entry CacheEntry, ok bool)
+	// Set stores a response for key.
+	Set(key string, entry CacheEntry)
+}
+
+// LRUCache is the default ResponseCache: an in-memory, least-recently-used
+// cache bounded to a fixed number of entries.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    []string
+	entries  map[string]CacheEntry
+}
+
+// NewLRUCache creates a ResponseCache holding up to capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRUCache{
+		capacity: capacity,
+		entries:  make(map[string]CacheEntry),
+	}
+}
+
+// Get returns the cached entry for key, if present.
+func (l *LRUCache) Get(key string) (entry CacheEntry, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry, ok = l.entries[key]
+	if ok {
+		l.touch(key)
+	}
+	return
+}
+
+// Set stores entry under key, evicting the least-recently-used entry if
+// the cache is at capacity.
+func (l *LRUCache) Set(key string, entry CacheEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, exists := l.entries[key]; !exists && len(l.entries) >= l.capacity {
+		oldest := l.order[0]
+		l.order = l.order[1:]
+		delete(l.entries, oldest)
+	}
+	l.entries[key] = entry
+	l.touch(key)
+}
+
+func (l *LRUCache) touch(key string) {
+	for i, k := range l.order {
+		if k == key {
+			l.order = append(l.order[:i], l.order[i+1:]...)
+			break
+		}
+	}
+	l.order = append(l.order, key)
+}
+
+// RetryPolicy controls how Client retries requests that fail with a 429 or
+// a 5xx response.
+type RetryPolicy struct {
+	// MaxRetries is the number of additional attempts after the first.
+	MaxRetries int
+	// BaseDelay is the starting backoff delay; it roughly doubles on each
+	// retry, unless the server sends a Retry-After header.
+	BaseDelay time.Duration
+}
+
+// DefaultRetryPolicy retries up to 3 times with exponential backoff
+// starting at 500ms.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  500 * time.Millisecond,
+}
+
+func (p RetryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+	backoff := p.BaseDelay * time.Duration(math.Pow(2, float64(attempt)))
+	return backoff + time.Duration(rand.Int63n(int64(backoff)/2+1))
+}
+
+// parseRetryAfter reads a Retry-After header, which ProPublica may send as
+// either a number of seconds or an HTTP date.
+func parseRetryAfter(h http.Header) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if when, err := http.ParseTime(v); err == nil {
+		return time.Until(when)
+	}
+	return 0
+}
+
+// get issues a GET request to url on behalf of ctx, applying the Client's
+// rate limiter, retry policy, and response cache, then decodes the JSON
+// response body into target.
+func (c *Client) get(ctx context.Context, url string, target interface{}) error {
+	var cached CacheEntry
+	var haveCached bool
+	if c.Cache != nil {
+		cached, haveCached = c.Cache.Get(url)
+		if haveCached && cached.fresh() {
+			return json.Unmarshal(cached.Body, target)
+		}
+	}
+
+	limiter := c.RateLimiter
+	if limiter == nil {
+		limiter = DefaultRateLimiter
+	}
+	if err := limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	policy := c.RetryPolicy
+	if policy == (RetryPolicy{}) {
+		policy = DefaultRetryPolicy
+	}
+
+	doer := c.HTTP
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Add("X-API-Key", c.Key)
+		if haveCached && cached.ETag != "" {
+			req.Header.Add("If-None-Match", cached.ETag)
+		}
+
+		resp, err := doer.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if resp.StatusCode == http.StatusNotModified && haveCached {
+			resp.Body.Close()
+			return json.Unmarshal(cached.Body, target)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			retryAfter := parseRetryAfter(resp.Header)
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			lastErr = newAPIError(resp.StatusCode, url, body, retryAfter)
+			if attempt == policy.MaxRetries {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(policy.delay(attempt, retryAfter)):
+			}
+			continue
+		}
+
+		body, err := readAndClose(resp)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode >= 400 {
+			return newAPIError(resp.StatusCode, url, body, 0)
+		}
+		if c.Cache != nil {
+			maxAge, ok := parseMaxAge(resp.Header)
+			if !ok {
+				maxAge = c.CacheTTL
+				if maxAge == 0 {
+					maxAge = DefaultCacheTTL
+				}
+			}
+			c.Cache.Set(url, CacheEntry{
+				Body:     body,
+				ETag:     resp.Header.Get("ETag"),
+				StoredAt: time.Now(),
+				MaxAge:   maxAge,
+			})
+		}
+		return json.Unmarshal(body, target)
+	}
+	return lastErr
+}
+
+func readAndClose(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
@@ -0,0 +1,136 @@
+package congress
+
+import (
+	"context"
+	"fmt"
+)
+
+// VoteSummary holds the data of a single roll call vote when sent as part
+// of a collection, such as the list of recent votes.
+type VoteSummary struct {
+	Congress    string `json:"congress"`
+	Chamber     string `json:"chamber"`
+	Session     int    `json:"session"`
+	RollCall    int    `json:"roll_call"`
+	Source      string `json:"source,omitempty"`
+	URL         string `json:"api_uri,omitempty"`
+	BillID      string `json:"bill_id,omitempty"`
+	Question    string `json:"question,omitempty"`
+	Description string `json:"description,omitempty"`
+	VoteType    string `json:"vote_type,omitempty"`
+	Date        string `json:"date"`
+	Time        string `json:"time,omitempty"`
+	Result      string `json:"result,omitempty"`
+
+	DemocraticYes  int `json:"democratic_yes,omitempty"`
+	DemocraticNo   int `json:"democratic_no,omitempty"`
+	RepublicanYes  int `json:"republican_yes,omitempty"`
+	RepublicanNo   int `json:"republican_no,omitempty"`
+	IndependentYes int `json:"independent_yes,omitempty"`
+	IndependentNo  int `json:"independent_no,omitempty"`
+
+	TotalYes       int `json:"total_yes,omitempty"`
+	TotalNo        int `json:"total_no,omitempty"`
+	TotalNotVoting int `json:"total_not_voting,omitempty"`
+}
+
+// VoteDetails holds the data of a single roll call vote when it is
+// requested specifically about that vote, including how every member
+// voted.
+type VoteDetails struct {
+	VoteSummary
+	Positions []MemberVote `json:"positions,omitempty"`
+}
+
+// MemberVote records how a single member of Congress voted on a single
+// roll call vote, or how a single member has voted across many votes.
+type MemberVote struct {
+	MemberID     string `json:"member_id"`
+	Name         string `json:"name,omitempty"`
+	Party        string `json:"party,omitempty"`
+	State        string `json:"state,omitempty"`
+	VotePosition string `json:"vote_position"`
+
+	// Fields only present when fetched from a member's vote history:
+	Congress    string `json:"congress,omitempty"`
+	Chamber     string `json:"chamber,omitempty"`
+	Session     int    `json:"session,omitempty"`
+	RollCall    int    `json:"roll_call,omitempty"`
+	BillID      string `json:"bill_id,omitempty"`
+	Question    string `json:"question,omitempty"`
+	Description string `json:"description,omitempty"`
+	Date        string `json:"date,omitempty"`
+	Result      string `json:"result,omitempty"`
+}
+
+// getVotesResponse is the format of the response received from the
+// Congress API "recent votes" endpoint.
+type getVotesResponse struct {
+	Status    string          `json:"status"`
+	Copyright string          `json:"copyright"`
+	Results   getVotesResults `json:"results"`
+}
+
+type getVotesResults struct {
+	Votes []VoteSummary `json:"votes"`
+}
+
+// GetRecentVotes fetches the most recent roll call votes for a single
+// chamber of a single congress.
+func (c *Client) GetRecentVotes(ctx context.Context, congress int, chamber string) (votes []VoteSummary, err error) {
+	var unmarshaled getVotesResponse
+	err = c.get(ctx, fmt.Sprintf("%s/%d/%s/votes/recent.json", c.Endpoint, congress, chamber), &unmarshaled)
+	if err != nil {
+		return
+	}
+	votes = unmarshaled.Results.Votes
+	return
+}
+
+// getVoteResponse is the format of the response received from the
+// Congress API "get vote" endpoint.
+type getVoteResponse struct {
+	Status    string      `json:"status"`
+	Copyright string      `json:"copyright"`
+	Results   VoteDetails `json:"results"`
+}
+
+// GetVote fetches detailed information about a single roll call vote,
+// including the position taken by every member who voted.
+func (c *Client) GetVote(ctx context.Context, congress int, chamber string, session, rollCall int) (vote VoteDetails, err error) {
+	var unmarshaled getVoteResponse
+	err = c.get(ctx, fmt.Sprintf("%s/%d/%s/sessions/%d/votes/%d.json", c.Endpoint, congress, chamber, session, rollCall), &unmarshaled)
+	if err != nil {
+		return
+	}
+	vote = unmarshaled.Results
+	return
+}
+
+// getMemberVotesResponse is the format of the response received from the
+// Congress API "get member votes" endpoint.
+type getMemberVotesResponse struct {
+	Status    string                  `json:"status"`
+	Copyright string                  `json:"copyright"`
+	Results   []getMemberVotesResults `json:"results"`
+}
+
+type getMemberVotesResults struct {
+	ID    string       `json:"id"`
+	Name  string       `json:"name"`
+	Votes []MemberVote `json:"votes"`
+}
+
+// GetMemberVotes fetches the voting history of a single member of Congress,
+// most recent first.
+func (c *Client) GetMemberVotes(ctx context.Context, memberID string) (votes []MemberVote, err error) {
+	var unmarshaled getMemberVotesResponse
+	err = c.get(ctx, fmt.Sprintf("%s/members/%s/votes.json", c.Endpoint, memberID), &unmarshaled)
+	if err != nil {
+		return
+	}
+	if len(unmarshaled.Results) > 0 {
+		votes = unmarshaled.Results[0].Votes
+	}
+	return
+}